@@ -0,0 +1,40 @@
+// Package hclogutils contains utility functions for converting between the
+// types used by this module and the types used by go-hclog.
+package hclogutils
+
+import (
+	"github.com/hashicorp/go-hclog"
+)
+
+// MapsToArgs flattens a list of maps of fields into a single slice of
+// alternating key-value pairs, suitable for passing as the `args` parameter
+// to an hclog.Logger method.
+//
+// Maps are flattened in the order they are passed, and keys within a given
+// map are not sorted. Duplicate keys are not removed: a key present in
+// several maps appears once per map, with the later maps' pairs after the
+// earlier ones.
+func MapsToArgs(maps ...map[string]interface{}) []interface{} {
+	var args []interface{}
+
+	for _, m := range maps {
+		for k, v := range m {
+			args = append(args, k, v)
+		}
+	}
+
+	return args
+}
+
+// LoggerOptionsCopy returns a shallow copy of the passed hclog.LoggerOptions,
+// so callers can derive a new logger configuration from an existing one
+// without mutating the original.
+func LoggerOptionsCopy(opts *hclog.LoggerOptions) *hclog.LoggerOptions {
+	if opts == nil {
+		return nil
+	}
+
+	copied := *opts
+
+	return &copied
+}
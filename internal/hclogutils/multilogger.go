@@ -0,0 +1,152 @@
+package hclogutils
+
+import (
+	"io"
+	"log"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// MultiLogger returns an hclog.Logger that dispatches every call to each of
+// loggers, honoring each one's own level so that, for example, a verbose
+// file sink and a terse stderr sink can be driven by the same logging
+// calls. loggers must not be empty.
+func MultiLogger(loggers ...hclog.Logger) hclog.Logger {
+	return &multiLogger{loggers: loggers}
+}
+
+type multiLogger struct {
+	loggers []hclog.Logger
+}
+
+func (m *multiLogger) Log(level hclog.Level, msg string, args ...interface{}) {
+	for _, l := range m.loggers {
+		l.Log(level, msg, args...)
+	}
+}
+
+func (m *multiLogger) Trace(msg string, args ...interface{}) {
+	for _, l := range m.loggers {
+		l.Trace(msg, args...)
+	}
+}
+
+func (m *multiLogger) Debug(msg string, args ...interface{}) {
+	for _, l := range m.loggers {
+		l.Debug(msg, args...)
+	}
+}
+
+func (m *multiLogger) Info(msg string, args ...interface{}) {
+	for _, l := range m.loggers {
+		l.Info(msg, args...)
+	}
+}
+
+func (m *multiLogger) Warn(msg string, args ...interface{}) {
+	for _, l := range m.loggers {
+		l.Warn(msg, args...)
+	}
+}
+
+func (m *multiLogger) Error(msg string, args ...interface{}) {
+	for _, l := range m.loggers {
+		l.Error(msg, args...)
+	}
+}
+
+func (m *multiLogger) IsTrace() bool { return m.anyIs(hclog.Logger.IsTrace) }
+func (m *multiLogger) IsDebug() bool { return m.anyIs(hclog.Logger.IsDebug) }
+func (m *multiLogger) IsInfo() bool  { return m.anyIs(hclog.Logger.IsInfo) }
+func (m *multiLogger) IsWarn() bool  { return m.anyIs(hclog.Logger.IsWarn) }
+func (m *multiLogger) IsError() bool { return m.anyIs(hclog.Logger.IsError) }
+
+// anyIs reports whether any of the fanned-out loggers would emit at the
+// level check reports true for, so that callers gating expensive log
+// argument construction on e.g. IsTrace() don't skip it when only one sink
+// is verbose enough to want it.
+func (m *multiLogger) anyIs(check func(hclog.Logger) bool) bool {
+	for _, l := range m.loggers {
+		if check(l) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *multiLogger) ImpliedArgs() []interface{} {
+	if len(m.loggers) == 0 {
+		return nil
+	}
+
+	return m.loggers[0].ImpliedArgs()
+}
+
+func (m *multiLogger) With(args ...interface{}) hclog.Logger {
+	loggers := make([]hclog.Logger, len(m.loggers))
+	for i, l := range m.loggers {
+		loggers[i] = l.With(args...)
+	}
+
+	return &multiLogger{loggers: loggers}
+}
+
+func (m *multiLogger) Name() string {
+	if len(m.loggers) == 0 {
+		return ""
+	}
+
+	return m.loggers[0].Name()
+}
+
+func (m *multiLogger) Named(name string) hclog.Logger {
+	loggers := make([]hclog.Logger, len(m.loggers))
+	for i, l := range m.loggers {
+		loggers[i] = l.Named(name)
+	}
+
+	return &multiLogger{loggers: loggers}
+}
+
+func (m *multiLogger) ResetNamed(name string) hclog.Logger {
+	loggers := make([]hclog.Logger, len(m.loggers))
+	for i, l := range m.loggers {
+		loggers[i] = l.ResetNamed(name)
+	}
+
+	return &multiLogger{loggers: loggers}
+}
+
+func (m *multiLogger) SetLevel(level hclog.Level) {
+	for _, l := range m.loggers {
+		l.SetLevel(level)
+	}
+}
+
+func (m *multiLogger) GetLevel() hclog.Level {
+	if len(m.loggers) == 0 {
+		return hclog.NoLevel
+	}
+
+	return m.loggers[0].GetLevel()
+}
+
+// StandardLogger and StandardWriter can only ever return a single
+// destination, so, unlike every other method, they aren't fanned out: they
+// delegate to the first configured sink.
+func (m *multiLogger) StandardLogger(opts *hclog.StandardLoggerOptions) *log.Logger {
+	if len(m.loggers) == 0 {
+		return nil
+	}
+
+	return m.loggers[0].StandardLogger(opts)
+}
+
+func (m *multiLogger) StandardWriter(opts *hclog.StandardLoggerOptions) io.Writer {
+	if len(m.loggers) == 0 {
+		return io.Discard
+	}
+
+	return m.loggers[0].StandardWriter(opts)
+}
@@ -0,0 +1,84 @@
+package hclogutils
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+func TestMultiLogger_fansOutRespectingEachSinksLevel(t *testing.T) {
+	var traceBuf, warnBuf bytes.Buffer
+
+	traceSink := hclog.New(&hclog.LoggerOptions{Name: "trace-sink", Level: hclog.Trace, Output: &traceBuf, JSONFormat: true})
+	warnSink := hclog.New(&hclog.LoggerOptions{Name: "warn-sink", Level: hclog.Warn, Output: &warnBuf, JSONFormat: true})
+
+	m := MultiLogger(traceSink, warnSink)
+
+	m.Debug("a debug message")
+
+	if !strings.Contains(traceBuf.String(), "a debug message") {
+		t.Errorf("expected the trace-level sink to receive the debug message, got %q", traceBuf.String())
+	}
+	if strings.Contains(warnBuf.String(), "a debug message") {
+		t.Errorf("expected the warn-level sink to filter out the debug message, got %q", warnBuf.String())
+	}
+
+	m.Warn("a warn message")
+
+	if !strings.Contains(traceBuf.String(), "a warn message") {
+		t.Errorf("expected the trace-level sink to also receive the warn message, got %q", traceBuf.String())
+	}
+	if !strings.Contains(warnBuf.String(), "a warn message") {
+		t.Errorf("expected the warn-level sink to receive the warn message, got %q", warnBuf.String())
+	}
+}
+
+func TestMultiLogger_isTraceIsAnyNotAll(t *testing.T) {
+	traceSink := hclog.New(&hclog.LoggerOptions{Level: hclog.Trace, Output: &bytes.Buffer{}})
+	offSink := hclog.New(&hclog.LoggerOptions{Level: hclog.Off, Output: &bytes.Buffer{}})
+
+	m := MultiLogger(traceSink, offSink)
+
+	if !m.IsTrace() {
+		t.Error("expected IsTrace to be true when any fanned-out sink is trace-level, so callers don't skip building trace args")
+	}
+
+	m2 := MultiLogger(offSink)
+	if m2.IsError() {
+		t.Error("expected IsError to be false when no fanned-out sink would emit at any level")
+	}
+}
+
+func TestMultiLogger_withAndNamedFanOutToEverySink(t *testing.T) {
+	var buf1, buf2 bytes.Buffer
+
+	sink1 := hclog.New(&hclog.LoggerOptions{Level: hclog.Trace, Output: &buf1, JSONFormat: true})
+	sink2 := hclog.New(&hclog.LoggerOptions{Level: hclog.Trace, Output: &buf2, JSONFormat: true})
+
+	m := MultiLogger(sink1, sink2)
+
+	named := m.Named("sub")
+	named.Info("hello")
+
+	if !strings.Contains(buf1.String(), `"sub"`) {
+		t.Errorf("expected sink1 to be renamed, got %q", buf1.String())
+	}
+	if !strings.Contains(buf2.String(), `"sub"`) {
+		t.Errorf("expected sink2 to be renamed, got %q", buf2.String())
+	}
+
+	buf1.Reset()
+	buf2.Reset()
+
+	withArgs := m.With("request_id", "abc123")
+	withArgs.Info("hello again")
+
+	if !strings.Contains(buf1.String(), "abc123") {
+		t.Errorf("expected sink1 to carry the implied argument, got %q", buf1.String())
+	}
+	if !strings.Contains(buf2.String(), "abc123") {
+		t.Errorf("expected sink2 to carry the implied argument, got %q", buf2.String())
+	}
+}
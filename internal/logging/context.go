@@ -0,0 +1,160 @@
+package logging
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+type contextKey string
+
+const (
+	contextKeySDKRootLogger             contextKey = "sdk-root-logger"
+	contextKeySDKRootLoggerOptions      contextKey = "sdk-root-logger-options"
+	contextKeySDKRootTFLoggerOpts       contextKey = "sdk-root-tf-logger-opts"
+	contextKeyProviderRootLogger        contextKey = "provider-root-logger"
+	contextKeyProviderRootLoggerOptions contextKey = "provider-root-logger-options"
+	contextKeyProviderRootTFLoggerOpts  contextKey = "provider-root-tf-logger-opts"
+	contextKeySink                      contextKey = "sink"
+	contextKeySinkOptions               contextKey = "sink-options"
+)
+
+// SetSDKRootLogger returns a new context.Context that has the SDK root
+// logger attached to it.
+func SetSDKRootLogger(ctx context.Context, logger hclog.Logger) context.Context {
+	return context.WithValue(ctx, contextKeySDKRootLogger, logger)
+}
+
+// GetSDKRootLogger returns the SDK root logger currently attached to ctx, or
+// nil if one has not been set.
+func GetSDKRootLogger(ctx context.Context) hclog.Logger {
+	logger, ok := ctx.Value(contextKeySDKRootLogger).(hclog.Logger)
+	if !ok {
+		return nil
+	}
+
+	return logger
+}
+
+// SetSDKRootLoggerOptions returns a new context.Context that has the
+// hclog.LoggerOptions used to create the SDK root logger attached to it.
+func SetSDKRootLoggerOptions(ctx context.Context, opts *hclog.LoggerOptions) context.Context {
+	return context.WithValue(ctx, contextKeySDKRootLoggerOptions, opts)
+}
+
+// GetSDKRootLoggerOptions returns the hclog.LoggerOptions used to create the
+// SDK root logger currently attached to ctx, or nil if none have been set.
+func GetSDKRootLoggerOptions(ctx context.Context) *hclog.LoggerOptions {
+	opts, ok := ctx.Value(contextKeySDKRootLoggerOptions).(*hclog.LoggerOptions)
+	if !ok {
+		return nil
+	}
+
+	return opts
+}
+
+// SetProviderRootLogger returns a new context.Context that has the provider
+// root logger attached to it.
+func SetProviderRootLogger(ctx context.Context, logger hclog.Logger) context.Context {
+	return context.WithValue(ctx, contextKeyProviderRootLogger, logger)
+}
+
+// GetProviderRootLogger returns the provider root logger currently attached
+// to ctx, or nil if one has not been set.
+func GetProviderRootLogger(ctx context.Context) hclog.Logger {
+	logger, ok := ctx.Value(contextKeyProviderRootLogger).(hclog.Logger)
+	if !ok {
+		return nil
+	}
+
+	return logger
+}
+
+// SetProviderRootLoggerOptions returns a new context.Context that has the
+// hclog.LoggerOptions used to create the provider root logger attached to
+// it.
+func SetProviderRootLoggerOptions(ctx context.Context, opts *hclog.LoggerOptions) context.Context {
+	return context.WithValue(ctx, contextKeyProviderRootLoggerOptions, opts)
+}
+
+// GetProviderRootLoggerOptions returns the hclog.LoggerOptions used to
+// create the provider root logger currently attached to ctx, or nil if none
+// have been set.
+func GetProviderRootLoggerOptions(ctx context.Context) *hclog.LoggerOptions {
+	opts, ok := ctx.Value(contextKeyProviderRootLoggerOptions).(*hclog.LoggerOptions)
+	if !ok {
+		return nil
+	}
+
+	return opts
+}
+
+// SetSDKRootTFLoggerOpts returns a new context.Context that has the
+// TFLoggerOpts for the SDK root logger attached to it.
+func SetSDKRootTFLoggerOpts(ctx context.Context, opts TFLoggerOpts) context.Context {
+	return context.WithValue(ctx, contextKeySDKRootTFLoggerOpts, opts)
+}
+
+// GetSDKRootTFLoggerOpts returns the TFLoggerOpts for the SDK root logger
+// currently attached to ctx, or a zero-value TFLoggerOpts if none have been
+// set.
+func GetSDKRootTFLoggerOpts(ctx context.Context) TFLoggerOpts {
+	opts, ok := ctx.Value(contextKeySDKRootTFLoggerOpts).(TFLoggerOpts)
+	if !ok {
+		return TFLoggerOpts{}
+	}
+
+	return opts
+}
+
+// SetProviderRootTFLoggerOpts returns a new context.Context that has the
+// TFLoggerOpts for the provider root logger attached to it.
+func SetProviderRootTFLoggerOpts(ctx context.Context, opts TFLoggerOpts) context.Context {
+	return context.WithValue(ctx, contextKeyProviderRootTFLoggerOpts, opts)
+}
+
+// GetProviderRootTFLoggerOpts returns the TFLoggerOpts for the provider root
+// logger currently attached to ctx, or a zero-value TFLoggerOpts if none
+// have been set.
+func GetProviderRootTFLoggerOpts(ctx context.Context) TFLoggerOpts {
+	opts, ok := ctx.Value(contextKeyProviderRootTFLoggerOpts).(TFLoggerOpts)
+	if !ok {
+		return TFLoggerOpts{}
+	}
+
+	return opts
+}
+
+// SetSink returns a new context.Context that has the root sink logger
+// attached to it.
+func SetSink(ctx context.Context, logger hclog.Logger) context.Context {
+	return context.WithValue(ctx, contextKeySink, logger)
+}
+
+// GetSink returns the root sink logger currently attached to ctx, or nil if
+// one has not been set.
+func GetSink(ctx context.Context) hclog.Logger {
+	logger, ok := ctx.Value(contextKeySink).(hclog.Logger)
+	if !ok {
+		return nil
+	}
+
+	return logger
+}
+
+// SetSinkOptions returns a new context.Context that has the
+// hclog.LoggerOptions used to create the root sink logger attached to it.
+func SetSinkOptions(ctx context.Context, opts *hclog.LoggerOptions) context.Context {
+	return context.WithValue(ctx, contextKeySinkOptions, opts)
+}
+
+// GetSinkOptions returns the hclog.LoggerOptions used to create the root
+// sink logger currently attached to ctx, or nil if none have been set.
+func GetSinkOptions(ctx context.Context) *hclog.LoggerOptions {
+	opts, ok := ctx.Value(contextKeySinkOptions).(*hclog.LoggerOptions)
+	if !ok {
+		return nil
+	}
+
+	return opts
+}
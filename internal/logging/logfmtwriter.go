@@ -0,0 +1,107 @@
+package logging
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// logfmtPriorityKeys are written first, in this order, on every logfmt
+// line, ahead of the remaining fields, which are sorted for determinism.
+// These are the names hclog's JSON encoder gives the timestamp, level, and
+// message.
+var logfmtPriorityKeys = []string{"@timestamp", "@level", "@message"}
+
+// NewLogfmtWriter returns an io.Writer that transcodes the JSON lines
+// written to it, as produced by an hclog.Logger configured with
+// JSONFormat: true, into logfmt (key=value) lines, and writes the result
+// to out. It's how LogfmtSinkFormat is implemented, since go-hclog itself
+// only ever writes JSON or its own human-readable format.
+func NewLogfmtWriter(out io.Writer) io.Writer {
+	return &logfmtWriter{out: out}
+}
+
+type logfmtWriter struct {
+	out io.Writer
+}
+
+func (w *logfmtWriter) Write(p []byte) (int, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(p))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+
+		if err := w.writeLine(line); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// writeLine transcodes a single JSON log line into logfmt. Lines that
+// aren't a JSON object, which hclog can still write directly through
+// StandardWriter, are passed through unchanged rather than dropped.
+func (w *logfmtWriter) writeLine(line []byte) error {
+	var fields map[string]interface{}
+	if err := json.Unmarshal(line, &fields); err != nil {
+		_, err := w.out.Write(append(append([]byte{}, line...), '\n'))
+		return err
+	}
+
+	var pairs []string
+
+	for _, key := range logfmtPriorityKeys {
+		if value, ok := fields[key]; ok {
+			pairs = append(pairs, key+"="+logfmtValue(value))
+			delete(fields, key)
+		}
+	}
+
+	keys := make([]string, 0, len(fields))
+	for key := range fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+logfmtValue(fields[key]))
+	}
+
+	_, err := io.WriteString(w.out, strings.Join(pairs, " ")+"\n")
+
+	return err
+}
+
+// logfmtValue renders a single field value in logfmt's key=value form,
+// quoting it if it contains whitespace or characters that would otherwise
+// make the line ambiguous to parse back out.
+func logfmtValue(value interface{}) string {
+	s, ok := value.(string)
+	if !ok {
+		b, err := json.Marshal(value)
+		if err != nil {
+			return strconv.Quote(strings.TrimSpace(string(b)))
+		}
+
+		s = string(b)
+	}
+
+	if s == "" || strings.ContainsAny(s, " \t\"=") {
+		return strconv.Quote(s)
+	}
+
+	return s
+}
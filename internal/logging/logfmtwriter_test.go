@@ -0,0 +1,64 @@
+package logging
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestLogfmtWriter(t *testing.T) {
+	testCases := map[string]struct {
+		jsonLine string
+		expected string
+	}{
+		"scalars": {
+			jsonLine: `{"@level":"trace","@message":"hello","@timestamp":"2023-01-01T00:00:00Z","count":3}`,
+			expected: `@timestamp=2023-01-01T00:00:00Z @level=trace @message=hello count=3`,
+		},
+		"value needing quotes": {
+			jsonLine: `{"@level":"debug","@message":"has spaces","@timestamp":"2023-01-01T00:00:00Z"}`,
+			expected: `@timestamp=2023-01-01T00:00:00Z @level=debug @message="has spaces"`,
+		},
+		"non-JSON line is passed through": {
+			jsonLine: `not json`,
+			expected: `not json`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			w := NewLogfmtWriter(&buf)
+			if _, err := w.Write([]byte(testCase.jsonLine + "\n")); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got := strings.TrimSuffix(buf.String(), "\n")
+			if got != testCase.expected {
+				t.Errorf("got %q, expected %q", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestLogfmtWriter_distinctFromHumanFormat(t *testing.T) {
+	// This is a regression test: LogfmtSinkFormat was previously
+	// implemented identically to HumanSinkFormat (hclog's bracketed
+	// "[TRACE] name: msg" form) minus color codes, which is not logfmt.
+	var buf bytes.Buffer
+
+	w := NewLogfmtWriter(&buf)
+	jsonLine := `{"@level":"trace","@message":"hello","@timestamp":"2023-01-01T00:00:00Z"}`
+	if _, err := w.Write([]byte(jsonLine + "\n")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := buf.String()
+	if strings.Contains(got, "[TRACE]") {
+		t.Errorf("logfmt output looks like hclog's human format: %q", got)
+	}
+	if !strings.Contains(got, "@level=trace") {
+		t.Errorf("logfmt output missing expected key=value pair: %q", got)
+	}
+}
@@ -0,0 +1,204 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// maskArgsWithMatchers masks, in place, every value in args (a slice of
+// alternating keys and values) that one of matchers determines should be
+// masked, walking into maps, slices, arrays, and structs to catch secrets
+// nested inside an otherwise unremarkable field.
+func maskArgsWithMatchers(args []interface{}, matchers []Matcher) {
+	if len(matchers) == 0 {
+		return
+	}
+
+	for i := 0; i < len(args)-1; i += 2 {
+		key, ok := args[i].(string)
+		if !ok {
+			continue
+		}
+
+		args[i+1] = safeMaskValue(key, args[i+1], matchers)
+	}
+}
+
+// safeMaskValue masks value using maskValueWithMatchers, recovering from
+// any panic an unexpected value shape causes during the reflection-based
+// walk. A bug in a Matcher, or in the walk itself, should never be able to
+// crash a provider; the original, unmasked value is returned in that case.
+func safeMaskValue(key string, value interface{}, matchers []Matcher) (result interface{}) {
+	result = value
+
+	defer func() {
+		if recover() != nil {
+			result = value
+		}
+	}()
+
+	masked, _ := maskValueWithMatchers(key, value, matchers)
+
+	return masked
+}
+
+// maskValueWithMatchers returns the masked form of value, and whether
+// masking actually changed anything. Callers that don't need to rebuild a
+// container around the result (safeMaskValue) can ignore changed; the
+// recursive walk in maskNestedValue uses it to leave containers that have
+// nothing to mask byte-for-byte as they were.
+func maskValueWithMatchers(key string, value interface{}, matchers []Matcher) (masked interface{}, changed bool) {
+	if value == nil {
+		return value, false
+	}
+
+	for _, m := range matchers {
+		if m.Match(key, value) {
+			return maskValue, true
+		}
+	}
+
+	return maskNestedValue(key, value, matchers)
+}
+
+// maskNestedValue walks into the containers reflection exposes - maps,
+// slices, arrays, pointers, and structs - masking any leaf value a Matcher
+// matches. If nothing inside value matches, value is returned completely
+// unchanged: rebuilding a container that didn't need masking would, for
+// maps and slices, be harmless, but for structs it would silently destroy
+// unexported-field types such as time.Time or error (which encode via
+// their own MarshalJSON, not their exported fields) and drop any
+// json-tagged renaming hclog's own encoding would otherwise apply.
+func maskNestedValue(key string, value interface{}, matchers []Matcher) (masked interface{}, changed bool) {
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Map:
+		out := make(map[string]interface{}, v.Len())
+		any := false
+
+		for _, mk := range v.MapKeys() {
+			elemKey := fmt.Sprintf("%v", mk.Interface())
+			elemMasked, elemChanged := maskValueWithMatchers(elemKey, v.MapIndex(mk).Interface(), matchers)
+			out[elemKey] = elemMasked
+			any = any || elemChanged
+		}
+
+		if !any {
+			return value, false
+		}
+
+		// Rebuilt as map[string]interface{}, regardless of the original
+		// map's concrete value type: maskValueWithMatchers can return a
+		// masked entry of any shape (the mask string, a
+		// map[string]interface{} for a nested struct, a []interface{}
+		// for a nested slice, ...), and reflect.Value.SetMapIndex panics
+		// if that doesn't match the original map's value type.
+		return out, true
+	case reflect.Slice, reflect.Array:
+		out := make([]interface{}, v.Len())
+		any := false
+
+		for i := 0; i < v.Len(); i++ {
+			elemMasked, elemChanged := maskValueWithMatchers(key, v.Index(i).Interface(), matchers)
+			out[i] = elemMasked
+			any = any || elemChanged
+		}
+
+		if !any {
+			return value, false
+		}
+
+		return out, true
+	case reflect.Ptr:
+		if v.IsNil() {
+			return value, false
+		}
+
+		elemMasked, elemChanged := maskValueWithMatchers(key, v.Elem().Interface(), matchers)
+		if !elemChanged {
+			return value, false
+		}
+
+		return elemMasked, true
+	case reflect.Struct:
+		return maskStruct(v, matchers)
+	default:
+		return value, false
+	}
+}
+
+// maskStruct masks the exported fields of v, a reflect.Value of struct
+// kind, returning the original value unchanged if nothing inside it
+// matched. When something did match, the rebuilt representation is
+// produced by masking v's own json.Marshal output rather than v's
+// reflected fields directly, so that json struct tags, MarshalJSON
+// methods (time.Time, error implementations, ...), and field renaming are
+// respected exactly as hclog's own JSON encoding of an unmasked value
+// would respect them.
+func maskStruct(v reflect.Value, matchers []Matcher) (masked interface{}, changed bool) {
+	value := v.Interface()
+	t := v.Type()
+
+	fieldChanges := make(map[string]interface{})
+
+	for i := 0; i < v.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue // unexported: never reachable via reflection, and never part of the JSON encoding anyway
+		}
+
+		jsonName, omit := jsonFieldName(field)
+		if omit {
+			continue
+		}
+
+		fieldMasked, fieldChanged := maskValueWithMatchers(field.Name, v.Field(i).Interface(), matchers)
+		if fieldChanged {
+			fieldChanges[jsonName] = fieldMasked
+		}
+	}
+
+	if len(fieldChanges) == 0 {
+		return value, false
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		// We know at least one field needs masking, but can't rebuild a
+		// JSON-shaped representation to merge the masked fields into.
+		// Mask the whole value rather than risk leaking the field that
+		// matched.
+		return maskValue, true
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(encoded, &out); err != nil {
+		return maskValue, true
+	}
+
+	for jsonName, fieldMasked := range fieldChanges {
+		out[jsonName] = fieldMasked
+	}
+
+	return out, true
+}
+
+// jsonFieldName returns the key field is encoded under by encoding/json,
+// honoring its `json:"..."` tag, and whether the tag excludes it from the
+// encoding entirely (a bare `json:"-"`).
+func jsonFieldName(field reflect.StructField) (name string, omit bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+
+	name = strings.SplitN(tag, ",", 2)[0]
+	if name == "" {
+		name = field.Name
+	}
+
+	return name, false
+}
@@ -0,0 +1,179 @@
+package logging
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+)
+
+type testInner struct {
+	Secret string
+	Public string
+}
+
+type testOuter struct {
+	Inner testInner
+	Tags  []string
+}
+
+func secretMatcher() Matcher {
+	return NewRegexMatcher(regexp.MustCompile(`^secret-value$`))
+}
+
+func TestMaskArgsWithMatchers_mapOfConcreteStruct(t *testing.T) {
+	// Regression test: a map whose value type is a concrete struct (not
+	// map[string]interface{}) previously panicked when rebuilt with its
+	// original type, and the panic was swallowed by safeMaskValue's
+	// recover(), silently logging the secret in the clear instead of
+	// masking it.
+	args := []interface{}{
+		"config", map[string]testOuter{
+			"a": {Inner: testInner{Secret: "secret-value", Public: "ok"}},
+		},
+	}
+
+	maskArgsWithMatchers(args, []Matcher{secretMatcher()})
+
+	masked, ok := args[1].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected masked map[string]interface{}, got %T", args[1])
+	}
+
+	outer, ok := masked["a"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected masked outer struct as map[string]interface{}, got %T", masked["a"])
+	}
+
+	inner, ok := outer["Inner"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected masked inner struct as map[string]interface{}, got %T", outer["Inner"])
+	}
+
+	if inner["Secret"] != maskValue {
+		t.Errorf("expected Secret to be masked, got %v", inner["Secret"])
+	}
+	if inner["Public"] != "ok" {
+		t.Errorf("expected Public to be left as-is, got %v", inner["Public"])
+	}
+}
+
+func TestMaskArgsWithMatchers_nestedSliceAndMapOfInterface(t *testing.T) {
+	args := []interface{}{
+		"tags", []string{"secret-value", "fine"},
+		"attrs", map[string]interface{}{"password": "secret-value", "name": "fine"},
+	}
+
+	maskArgsWithMatchers(args, []Matcher{secretMatcher()})
+
+	tags, ok := args[1].([]interface{})
+	if !ok {
+		t.Fatalf("expected masked slice as []interface{}, got %T", args[1])
+	}
+	if !reflect.DeepEqual(tags, []interface{}{maskValue, "fine"}) {
+		t.Errorf("got %v", tags)
+	}
+
+	attrs, ok := args[3].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected masked map as map[string]interface{}, got %T", args[3])
+	}
+	if attrs["password"] != maskValue || attrs["name"] != "fine" {
+		t.Errorf("got %v", attrs)
+	}
+}
+
+func TestMaskArgsWithMatchers_noMatchersIsNoOp(t *testing.T) {
+	args := []interface{}{"key", "secret-value"}
+
+	maskArgsWithMatchers(args, nil)
+
+	if args[1] != "secret-value" {
+		t.Errorf("expected value to be left as-is when there are no matchers, got %v", args[1])
+	}
+}
+
+func TestSafeMaskValue_recoversFromPanickingMatcher(t *testing.T) {
+	panicking := panicMatcher{}
+
+	result := safeMaskValue("key", "value", []Matcher{panicking})
+	if result != "value" {
+		t.Errorf("expected original value back when a Matcher panics, got %v", result)
+	}
+}
+
+type panicMatcher struct{}
+
+func (panicMatcher) Match(string, interface{}) bool {
+	panic("boom")
+}
+
+func TestMaskArgsWithMatchers_unmatchedStructIsLeftUntouched(t *testing.T) {
+	// Regression test: a struct with no matching field previously got
+	// rebuilt into a map[string]interface{} of only its exported fields
+	// regardless, which silently destroyed values such as time.Time and
+	// error whose state lives in unexported fields - turning them into
+	// "{}" in the emitted log line even though nothing matched.
+	now := time.Now()
+	err := errors.New("boom")
+
+	args := []interface{}{
+		"started_at", now,
+		"err", err,
+	}
+
+	// A matcher that matches neither of these values, the same as a
+	// provider that has configured masking for fields unrelated to these
+	// two.
+	maskArgsWithMatchers(args, []Matcher{secretMatcher()})
+
+	if args[1] != now {
+		t.Errorf("expected time.Time to be returned unchanged, got %#v", args[1])
+	}
+	if args[3] != err {
+		t.Errorf("expected error to be returned unchanged, got %#v", args[3])
+	}
+}
+
+func TestMaskArgsWithMatchers_unmatchedNestedContainersAreLeftUntouched(t *testing.T) {
+	original := map[string]testOuter{
+		"a": {Inner: testInner{Secret: "fine", Public: "also fine"}},
+	}
+	args := []interface{}{"config", original}
+
+	maskArgsWithMatchers(args, []Matcher{secretMatcher()})
+
+	if !reflect.DeepEqual(args[1], original) {
+		t.Errorf("expected unmatched map to be left byte-for-byte as-is, got %#v", args[1])
+	}
+}
+
+type taggedStruct struct {
+	Secret   string `json:"secret_value"`
+	Public   string
+	Internal string `json:"-"`
+}
+
+func TestMaskArgsWithMatchers_structRebuildRespectsJSONTags(t *testing.T) {
+	args := []interface{}{
+		"config", taggedStruct{Secret: "secret-value", Public: "fine", Internal: "never logged"},
+	}
+
+	maskArgsWithMatchers(args, []Matcher{secretMatcher()})
+
+	masked, ok := args[1].(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected masked struct as map[string]interface{}, got %T", args[1])
+	}
+
+	if masked["secret_value"] != maskValue {
+		t.Errorf("expected the json-tagged key secret_value to carry the mask, got %v", masked["secret_value"])
+	}
+	if masked["Public"] != "fine" {
+		t.Errorf("expected Public to be left as-is, got %v", masked["Public"])
+	}
+	if _, ok := masked["Internal"]; ok {
+		t.Errorf("expected json:\"-\" field to be excluded, as encoding/json would exclude it, got %v", masked["Internal"])
+	}
+}
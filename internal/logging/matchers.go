@@ -0,0 +1,63 @@
+package logging
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Matcher inspects a field's key and its value and decides whether the
+// value should be masked. Match is first called with a field's top-level
+// value; if that doesn't match, every map entry, slice/array element,
+// pointed-to value, and exported struct field reachable from it is visited
+// and matched on its own, so a Matcher written against a scalar also
+// catches that scalar nested arbitrarily deep inside a struct, slice, or
+// map.
+type Matcher interface {
+	// Match returns true if value, held at key or nested anywhere within
+	// a field's value, should be masked.
+	Match(key string, value interface{}) bool
+}
+
+// regexValueMatcher is a Matcher that masks any value whose string
+// representation matches the wrapped regular expression, regardless of
+// key.
+type regexValueMatcher struct {
+	expression *regexp.Regexp
+}
+
+// Match implements Matcher.
+func (m regexValueMatcher) Match(_ string, value interface{}) bool {
+	s, ok := value.(string)
+	if !ok {
+		s = fmt.Sprintf("%v", value)
+	}
+
+	return m.expression.MatchString(s)
+}
+
+// NewRegexMatcher returns a Matcher that masks any value whose string
+// representation matches expression, regardless of key. It's what
+// MaskFieldValuesWithRegexes builds under the hood, exposed so that
+// regex-based matchers can be composed with others via
+// MaskFieldValuesWithMatchers.
+func NewRegexMatcher(expression *regexp.Regexp) Matcher {
+	return regexValueMatcher{expression: expression}
+}
+
+// AWSAccessKeyMatcher masks values that contain what looks like an AWS
+// access key ID.
+var AWSAccessKeyMatcher Matcher = regexValueMatcher{
+	expression: regexp.MustCompile(`\b(?:A3T[A-Z0-9]|AKIA|AGPA|AIDA|AROA|AIPA|ANPA|ANVA|ASIA)[A-Z0-9]{16}\b`),
+}
+
+// BearerTokenMatcher masks values that contain what looks like an HTTP
+// Bearer token, such as an OAuth2 access token or a JWT.
+var BearerTokenMatcher Matcher = regexValueMatcher{
+	expression: regexp.MustCompile(`(?i)\bbearer\s+[A-Za-z0-9\-._~+/]+=*`),
+}
+
+// PrivateKeyMatcher masks values that contain a PEM-encoded private key
+// block.
+var PrivateKeyMatcher Matcher = regexValueMatcher{
+	expression: regexp.MustCompile(`-----BEGIN [A-Z0-9 ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z0-9 ]*PRIVATE KEY-----`),
+}
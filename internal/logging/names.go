@@ -0,0 +1,11 @@
+package logging
+
+const (
+	// DefaultSDKRootLoggerName is the default name for the root SDK logger,
+	// used when LoggerOpts.Name is empty.
+	DefaultSDKRootLoggerName = "sdk"
+
+	// DefaultProviderRootLoggerName is the default name for the root
+	// provider logger, used when LoggerOpts.Name is empty.
+	DefaultProviderRootLoggerName = "provider"
+)
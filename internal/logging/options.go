@@ -0,0 +1,130 @@
+package logging
+
+import (
+	"io"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// LoggerOpts captures the options supplied to NewRootSDKLogger and
+// NewRootProviderLogger, separate from the TFLoggerOpts, which control
+// omitting and masking of log entries.
+type LoggerOpts struct {
+	// Name is the name of the logger. If unset, a subsystem-specific
+	// default is used.
+	Name string
+
+	// Level is the most verbose level the logger will emit logs at.
+	Level hclog.Level
+
+	// IncludeLocation indicates whether logs should include the location
+	// of the logging call.
+	IncludeLocation bool
+
+	// IncludeTime indicates whether logs should include the time they
+	// were emitted at.
+	IncludeTime bool
+
+	// AdditionalLocationOffset is the number of additional stack frames
+	// to skip when determining the location of the logging call.
+	AdditionalLocationOffset int
+
+	// Output is the io.Writer the logger should write logs to.
+	Output io.Writer
+
+	// TraceCorrelationPropagator, when set, is consulted on every log call
+	// to attach OpenTelemetry trace correlation fields to the log entry.
+	TraceCorrelationPropagator TraceCorrelationPropagator
+
+	// SamplingRule configures which fields a log entry's sampling identity
+	// is computed from. It only has an effect when RateLimitRule is also
+	// set.
+	SamplingRule SamplingRule
+
+	// RateLimitRule, when set, caps the volume of repeated log entries
+	// emitted through tfsdklog.Trace/Debug/etc.
+	RateLimitRule RateLimitRule
+
+	// Sinks, when non-empty, replaces the single Output/JSON-formatted
+	// destination NewRootSDKLogger/NewRootProviderLogger otherwise write
+	// to with a fan-out to each of the listed sinks.
+	Sinks []SinkConfig
+}
+
+// Option is a functional option type that can be used to configure the
+// loggers constructed by NewRootSDKLogger and NewRootProviderLogger.
+type Option func(LoggerOpts) LoggerOpts
+
+// ApplyLoggerOpts applies all the passed options and returns the resulting
+// LoggerOpts.
+func ApplyLoggerOpts(opts ...Option) LoggerOpts {
+	var lo LoggerOpts
+	lo.IncludeTime = true
+
+	for _, opt := range opts {
+		lo = opt(lo)
+	}
+
+	return lo
+}
+
+// WithTraceCorrelation returns an option that, when passed to
+// NewRootSDKLogger, attaches trace_id, span_id, and trace_flags fields to
+// every log entry made with a context.Context that propagator can derive a
+// span from.
+//
+// This only takes effect on NewRootSDKLogger: nothing in this module reads
+// trace correlation fields back out of a provider root logger's context,
+// so passing this to NewRootProviderLogger has no effect.
+func WithTraceCorrelation(propagator TraceCorrelationPropagator) Option {
+	return func(lo LoggerOpts) LoggerOpts {
+		lo.TraceCorrelationPropagator = propagator
+
+		return lo
+	}
+}
+
+// WithSampling returns an option that configures the sampling identity
+// repeated log entries are grouped by. It only takes effect when combined
+// with WithRateLimit, and, like WithRateLimit, only on NewRootSDKLogger:
+// nothing in this module reads a provider root logger's TFLoggerOpts back
+// out for sampling purposes, so passing this to NewRootProviderLogger has
+// no effect.
+func WithSampling(rule SamplingRule) Option {
+	return func(lo LoggerOpts) LoggerOpts {
+		lo.SamplingRule = rule
+
+		return lo
+	}
+}
+
+// WithRateLimit returns an option that caps repeated log entries sharing a
+// sampling identity (see WithSampling) to perKey occurrences within
+// window. Entries beyond that quota are dropped until window elapses, at
+// which point the next allowed entry gains a "sampled_dropped" field
+// reporting how many were dropped.
+//
+// This only takes effect on NewRootSDKLogger; see WithSampling.
+func WithRateLimit(perKey int, window time.Duration) Option {
+	return func(lo LoggerOpts) LoggerOpts {
+		lo.RateLimitRule = RateLimitRule{
+			PerKey: perKey,
+			Window: window,
+		}
+
+		return lo
+	}
+}
+
+// WithSinks returns an option that fans a root logger's entries out to
+// each of the given sinks, instead of writing a single JSON-formatted
+// stream to Output. Each call to this function is additive: the sinks are
+// added to the existing configuration.
+func WithSinks(sinks ...SinkConfig) Option {
+	return func(lo LoggerOpts) LoggerOpts {
+		lo.Sinks = append(lo.Sinks, sinks...)
+
+		return lo
+	}
+}
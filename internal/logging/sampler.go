@@ -0,0 +1,123 @@
+package logging
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// SamplingRule configures which fields, beyond the log message itself, are
+// used to group repeated log entries together for sampling purposes. Two
+// entries with the same message and the same values for these fields are
+// treated as repeats of one another.
+type SamplingRule struct {
+	// FieldKeys are the additional field keys used to compute a log
+	// entry's sampling identity.
+	FieldKeys []string
+}
+
+// RateLimitRule caps how many times log entries sharing a sampling
+// identity may be emitted within Window before subsequent occurrences are
+// dropped and counted. The count is reported as a single "sampled_dropped"
+// field on the first entry with that identity after the window closes.
+type RateLimitRule struct {
+	// PerKey is the maximum number of times a given sampling identity may
+	// be logged within Window.
+	PerKey int
+
+	// Window is the duration a PerKey quota is measured over.
+	Window time.Duration
+}
+
+// sampler enforces a RateLimitRule, grouping log entries by the identity
+// SamplingRule describes. It is stored on the TFLoggerOpts of a root
+// logger, rather than LoggerOpts, so that it's reachable from omitOrMask,
+// which only has access to the former.
+type sampler struct {
+	rule RateLimitRule
+	keys []string
+
+	mu     sync.Mutex
+	states map[string]*samplerState
+}
+
+type samplerState struct {
+	windowStart time.Time
+	count       int
+	dropped     int
+}
+
+func newSampler(rule SamplingRule, rateLimit RateLimitRule) *sampler {
+	return &sampler{
+		rule:   rateLimit,
+		keys:   rule.FieldKeys,
+		states: make(map[string]*samplerState),
+	}
+}
+
+// Allow reports whether a log entry identified by msg and fields should be
+// emitted. When it returns false, the entry should be dropped entirely. If
+// a prior window in which entries were dropped closes on this call,
+// summary is non-nil and should be merged into the fields of the entry
+// being allowed through. The dropped count is only reported this way, so
+// it is never reported if no later entry with the same identity arrives.
+func (s *sampler) Allow(msg string, fields map[string]interface{}) (allow bool, summary map[string]interface{}) {
+	if s == nil || s.rule.PerKey <= 0 {
+		return true, nil
+	}
+
+	key := s.key(msg, fields)
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	state, ok := s.states[key]
+	if !ok || now.Sub(state.windowStart) >= s.rule.Window {
+		if ok && state.dropped > 0 {
+			summary = map[string]interface{}{"sampled_dropped": state.dropped}
+		}
+
+		s.states[key] = &samplerState{windowStart: now, count: 1}
+
+		return true, summary
+	}
+
+	state.count++
+	if state.count <= s.rule.PerKey {
+		return true, nil
+	}
+
+	state.dropped++
+
+	return false, nil
+}
+
+// key computes the sampling identity of a log entry from its message and
+// the values of the fields named in s.keys.
+func (s *sampler) key(msg string, fields map[string]interface{}) string {
+	h := sha256.New()
+	h.Write([]byte(msg))
+
+	for _, k := range s.keys {
+		fmt.Fprintf(h, "|%s=%v", k, fields[k])
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// mergeFields shallow-merges a list of field maps into one, in order, so
+// later maps overwrite earlier ones for a given key.
+func mergeFields(fields []map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{})
+
+	for _, m := range fields {
+		for k, v := range m {
+			merged[k] = v
+		}
+	}
+
+	return merged
+}
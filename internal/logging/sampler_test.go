@@ -0,0 +1,118 @@
+package logging
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSampler_allowsUpToPerKeyWithinWindow(t *testing.T) {
+	s := newSampler(SamplingRule{}, RateLimitRule{PerKey: 2, Window: time.Hour})
+
+	for i := 0; i < 2; i++ {
+		allow, summary := s.Allow("msg", nil)
+		if !allow {
+			t.Fatalf("entry %d: expected allow, got dropped", i)
+		}
+		if summary != nil {
+			t.Fatalf("entry %d: expected no summary, got %v", i, summary)
+		}
+	}
+}
+
+func TestSampler_dropsBeyondPerKeyWithinWindow(t *testing.T) {
+	s := newSampler(SamplingRule{}, RateLimitRule{PerKey: 1, Window: time.Hour})
+
+	if allow, _ := s.Allow("msg", nil); !allow {
+		t.Fatal("first entry should be allowed")
+	}
+
+	allow, summary := s.Allow("msg", nil)
+	if allow {
+		t.Fatal("second entry should be dropped once PerKey is exceeded")
+	}
+	if summary != nil {
+		t.Errorf("expected no summary on a dropped entry, got %v", summary)
+	}
+}
+
+func TestSampler_summaryOnWindowReopen(t *testing.T) {
+	s := newSampler(SamplingRule{}, RateLimitRule{PerKey: 1, Window: 10 * time.Millisecond})
+
+	if allow, _ := s.Allow("msg", nil); !allow {
+		t.Fatal("first entry should be allowed")
+	}
+
+	// Dropped twice while the window is still open.
+	for i := 0; i < 2; i++ {
+		if allow, _ := s.Allow("msg", nil); allow {
+			t.Fatalf("drop %d: expected entry to be dropped within the same window", i)
+		}
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	allow, summary := s.Allow("msg", nil)
+	if !allow {
+		t.Fatal("expected entry to be allowed once the window reopens")
+	}
+	if summary == nil {
+		t.Fatal("expected a summary reporting the dropped count on window reopen")
+	}
+	if summary["sampled_dropped"] != 2 {
+		t.Errorf("got sampled_dropped %v, expected 2", summary["sampled_dropped"])
+	}
+}
+
+func TestSampler_reopenWithoutPriorDropsHasNoSummary(t *testing.T) {
+	s := newSampler(SamplingRule{}, RateLimitRule{PerKey: 1, Window: 10 * time.Millisecond})
+
+	if allow, _ := s.Allow("msg", nil); !allow {
+		t.Fatal("first entry should be allowed")
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	allow, summary := s.Allow("msg", nil)
+	if !allow {
+		t.Fatal("expected entry to be allowed once the window reopens")
+	}
+	if summary != nil {
+		t.Errorf("expected no summary when nothing was dropped in the prior window, got %v", summary)
+	}
+}
+
+func TestSampler_keysBySamplingRuleFields(t *testing.T) {
+	s := newSampler(SamplingRule{FieldKeys: []string{"resource"}}, RateLimitRule{PerKey: 1, Window: time.Hour})
+
+	if allow, _ := s.Allow("msg", map[string]interface{}{"resource": "a"}); !allow {
+		t.Fatal("first entry for resource a should be allowed")
+	}
+	if allow, _ := s.Allow("msg", map[string]interface{}{"resource": "b"}); !allow {
+		t.Fatal("first entry for resource b should be allowed, as it has a distinct sampling identity")
+	}
+	if allow, _ := s.Allow("msg", map[string]interface{}{"resource": "a"}); allow {
+		t.Fatal("second entry for resource a should be dropped")
+	}
+}
+
+func TestSampler_nilSamplerAllowsEverything(t *testing.T) {
+	var s *sampler
+
+	allow, summary := s.Allow("msg", nil)
+	if !allow {
+		t.Error("expected a nil sampler to allow every entry")
+	}
+	if summary != nil {
+		t.Errorf("expected no summary from a nil sampler, got %v", summary)
+	}
+}
+
+func TestSampler_zeroPerKeyAllowsEverything(t *testing.T) {
+	s := newSampler(SamplingRule{}, RateLimitRule{})
+
+	for i := 0; i < 5; i++ {
+		if allow, _ := s.Allow("msg", nil); !allow {
+			t.Fatalf("entry %d: expected allow when no RateLimitRule is configured", i)
+		}
+	}
+}
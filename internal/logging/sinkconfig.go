@@ -0,0 +1,44 @@
+package logging
+
+import (
+	"io"
+
+	"github.com/hashicorp/go-hclog"
+)
+
+// SinkFormat is the line format a SinkConfig writes its logs in.
+type SinkFormat int
+
+const (
+	// JSONSinkFormat writes each log line as a JSON object, one per line.
+	// This is the format Terraform itself expects on a plugin's stderr,
+	// and is what NewRootSDKLogger/NewRootProviderLogger use when no
+	// sinks are configured explicitly.
+	JSONSinkFormat SinkFormat = iota
+
+	// LogfmtSinkFormat writes each log line as logfmt (key=value) pairs.
+	// go-hclog has no native logfmt writer, so this is implemented by
+	// transcoding hclog's own JSON encoding at write time; see
+	// logfmtWriter.
+	LogfmtSinkFormat
+
+	// HumanSinkFormat writes each log line in hclog's human-readable form,
+	// suitable for a developer's terminal. Output is colorized only when it
+	// is a terminal.
+	HumanSinkFormat
+)
+
+// SinkConfig configures one destination a root logger fans its log entries
+// out to.
+type SinkConfig struct {
+	// Level is the most verbose level this sink writes logs at. Entries
+	// below this level are not written to Output, even if another sink on
+	// the same logger would write them.
+	Level hclog.Level
+
+	// Format is the line format this sink writes logs in.
+	Format SinkFormat
+
+	// Output is the io.Writer this sink writes logs to.
+	Output io.Writer
+}
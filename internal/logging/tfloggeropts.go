@@ -0,0 +1,237 @@
+package logging
+
+import (
+	"context"
+	"regexp"
+	"strings"
+)
+
+// maskValue is what masked field values and message substrings are replaced
+// with.
+const maskValue = "***"
+
+// TFLoggerOpts holds the omitting and masking configuration for a root
+// logger, built up additively through the With* functions below and
+// consulted on every log call via ShouldOmit and ApplyMask.
+type TFLoggerOpts struct {
+	omitLogWithFieldKeys      []string
+	omitLogWithMessageRegexes []*regexp.Regexp
+	omitLogWithMessageStrings []string
+
+	maskFieldValuesWithFieldKeys []string
+	maskFieldValuesWithMatchers  []Matcher
+	maskMessageRegexes           []*regexp.Regexp
+	maskMessageStrings           []string
+
+	traceCorrelationPropagator TraceCorrelationPropagator
+
+	sampler *sampler
+}
+
+// WithSampler returns an option that sets the *sampler built from the
+// SamplingRule and RateLimitRule supplied to NewRootSDKLogger, for the
+// same reason WithTraceCorrelationPropagator
+// isn't exposed as its own public tfsdklog function.
+func WithSampler(rule SamplingRule, rateLimit RateLimitRule) func(TFLoggerOpts) TFLoggerOpts {
+	return func(opts TFLoggerOpts) TFLoggerOpts {
+		opts.sampler = newSampler(rule, rateLimit)
+
+		return opts
+	}
+}
+
+// Sample reports whether a log entry with msg and additionalFields should
+// be emitted, and, if a previously-sampled window just closed, the summary
+// field that should be merged into additionalFields before it's emitted.
+// If no rate limit has been configured, every entry is allowed through.
+func (opts TFLoggerOpts) Sample(msg string, additionalFields []map[string]interface{}) (allow bool, summary map[string]interface{}) {
+	if opts.sampler == nil {
+		return true, nil
+	}
+
+	return opts.sampler.Allow(msg, mergeFields(additionalFields))
+}
+
+// WithTraceCorrelationPropagator returns an option that sets the
+// TraceCorrelationPropagator consulted by TraceFields. It is set from the
+// LoggerOpts supplied to NewRootSDKLogger, rather than being exposed as its own public tfsdklog function, since it only
+// makes sense to configure once, at logger construction time.
+func WithTraceCorrelationPropagator(propagator TraceCorrelationPropagator) func(TFLoggerOpts) TFLoggerOpts {
+	return func(opts TFLoggerOpts) TFLoggerOpts {
+		opts.traceCorrelationPropagator = propagator
+
+		return opts
+	}
+}
+
+// TraceFields returns the trace correlation fields that should be attached
+// to a log entry made with ctx, if a TraceCorrelationPropagator has been
+// configured and ctx carries a span it can derive them from.
+func (opts TFLoggerOpts) TraceFields(ctx context.Context) (fields map[string]interface{}, ok bool) {
+	if opts.traceCorrelationPropagator == nil {
+		return nil, false
+	}
+
+	return opts.traceCorrelationPropagator.TraceFields(ctx)
+}
+
+// WithOmitLogWithFieldKeys returns an option that adds keys to the set of
+// field keys that cause a log to be omitted when present.
+func WithOmitLogWithFieldKeys(keys ...string) func(TFLoggerOpts) TFLoggerOpts {
+	return func(opts TFLoggerOpts) TFLoggerOpts {
+		opts.omitLogWithFieldKeys = append(opts.omitLogWithFieldKeys, keys...)
+
+		return opts
+	}
+}
+
+// WithOmitLogWithMessageRegexes returns an option that adds regular
+// expressions to the set that cause a log to be omitted when its message
+// matches.
+func WithOmitLogWithMessageRegexes(expressions ...*regexp.Regexp) func(TFLoggerOpts) TFLoggerOpts {
+	return func(opts TFLoggerOpts) TFLoggerOpts {
+		opts.omitLogWithMessageRegexes = append(opts.omitLogWithMessageRegexes, expressions...)
+
+		return opts
+	}
+}
+
+// WithOmitLogWithMessageStrings returns an option that adds strings to the
+// set that cause a log to be omitted when its message contains them.
+func WithOmitLogWithMessageStrings(matchingStrings ...string) func(TFLoggerOpts) TFLoggerOpts {
+	return func(opts TFLoggerOpts) TFLoggerOpts {
+		opts.omitLogWithMessageStrings = append(opts.omitLogWithMessageStrings, matchingStrings...)
+
+		return opts
+	}
+}
+
+// WithMaskFieldValuesWithFieldKeys returns an option that adds keys to the
+// set of field keys whose values should be masked.
+func WithMaskFieldValuesWithFieldKeys(keys ...string) func(TFLoggerOpts) TFLoggerOpts {
+	return func(opts TFLoggerOpts) TFLoggerOpts {
+		opts.maskFieldValuesWithFieldKeys = append(opts.maskFieldValuesWithFieldKeys, keys...)
+
+		return opts
+	}
+}
+
+// WithMaskFieldValuesWithMatchers returns an option that adds Matchers
+// whose matching field values, however deeply nested within a map, slice,
+// or struct, should be masked.
+func WithMaskFieldValuesWithMatchers(matchers ...Matcher) func(TFLoggerOpts) TFLoggerOpts {
+	return func(opts TFLoggerOpts) TFLoggerOpts {
+		opts.maskFieldValuesWithMatchers = append(opts.maskFieldValuesWithMatchers, matchers...)
+
+		return opts
+	}
+}
+
+// WithMaskFieldValuesWithRegexes returns an option that adds regular
+// expressions whose matching field values, however deeply nested within a
+// map, slice, or struct, should be masked. It's sugar for
+// WithMaskFieldValuesWithMatchers(NewRegexMatcher(expression), ...).
+func WithMaskFieldValuesWithRegexes(expressions ...*regexp.Regexp) func(TFLoggerOpts) TFLoggerOpts {
+	matchers := make([]Matcher, len(expressions))
+	for i, expression := range expressions {
+		matchers[i] = NewRegexMatcher(expression)
+	}
+
+	return WithMaskFieldValuesWithMatchers(matchers...)
+}
+
+// WithMaskMessageRegexes returns an option that adds regular expressions to
+// the set whose matches within a log message should be masked.
+func WithMaskMessageRegexes(expressions ...*regexp.Regexp) func(TFLoggerOpts) TFLoggerOpts {
+	return func(opts TFLoggerOpts) TFLoggerOpts {
+		opts.maskMessageRegexes = append(opts.maskMessageRegexes, expressions...)
+
+		return opts
+	}
+}
+
+// WithMaskMessageStrings returns an option that adds strings to the set
+// whose occurrences within a log message should be masked.
+func WithMaskMessageStrings(matchingStrings ...string) func(TFLoggerOpts) TFLoggerOpts {
+	return func(opts TFLoggerOpts) TFLoggerOpts {
+		opts.maskMessageStrings = append(opts.maskMessageStrings, matchingStrings...)
+
+		return opts
+	}
+}
+
+// ShouldOmit returns true if, based on the configuration in opts, the log
+// represented by msg, impliedArgs and additionalArgs should not be written
+// at all.
+func (opts TFLoggerOpts) ShouldOmit(msg *string, impliedArgs, additionalArgs []interface{}) bool {
+	if msg == nil {
+		return false
+	}
+
+	for _, key := range opts.omitLogWithFieldKeys {
+		if argsHaveKey(impliedArgs, key) || argsHaveKey(additionalArgs, key) {
+			return true
+		}
+	}
+
+	for _, re := range opts.omitLogWithMessageRegexes {
+		if re.MatchString(*msg) {
+			return true
+		}
+	}
+
+	for _, matchingString := range opts.omitLogWithMessageStrings {
+		if strings.Contains(*msg, matchingString) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ApplyMask mutates msg, impliedArgs and additionalArgs in place, replacing
+// any field value or message substring that matches the masking
+// configuration in opts with asterisks.
+func (opts TFLoggerOpts) ApplyMask(msg *string, impliedArgs, additionalArgs []interface{}) {
+	for _, key := range opts.maskFieldValuesWithFieldKeys {
+		maskArgsWithKey(impliedArgs, key)
+		maskArgsWithKey(additionalArgs, key)
+	}
+
+	maskArgsWithMatchers(impliedArgs, opts.maskFieldValuesWithMatchers)
+	maskArgsWithMatchers(additionalArgs, opts.maskFieldValuesWithMatchers)
+
+	if msg == nil {
+		return
+	}
+
+	for _, re := range opts.maskMessageRegexes {
+		*msg = re.ReplaceAllString(*msg, maskValue)
+	}
+
+	for _, matchingString := range opts.maskMessageStrings {
+		*msg = strings.ReplaceAll(*msg, matchingString, maskValue)
+	}
+}
+
+// argsHaveKey returns true if args, a slice of alternating keys and values,
+// contains key.
+func argsHaveKey(args []interface{}, key string) bool {
+	for i := 0; i < len(args)-1; i += 2 {
+		if k, ok := args[i].(string); ok && k == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// maskArgsWithKey replaces, in place, the value paired with key in args, a
+// slice of alternating keys and values, with asterisks.
+func maskArgsWithKey(args []interface{}, key string) {
+	for i := 0; i < len(args)-1; i += 2 {
+		if k, ok := args[i].(string); ok && k == key {
+			args[i+1] = maskValue
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package logging
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TraceCorrelationPropagator extracts the fields used to correlate a log
+// entry with an OpenTelemetry trace from the context.Context the logging
+// call was made with. Implementations are consulted on every log call, so
+// they should be cheap and must not block.
+type TraceCorrelationPropagator interface {
+	// TraceFields returns the fields to attach to a log entry made with
+	// ctx. ok is false if ctx does not carry a span these fields can be
+	// derived from, in which case fields is ignored and no correlation
+	// fields are attached.
+	TraceFields(ctx context.Context) (fields map[string]interface{}, ok bool)
+}
+
+// OTelTraceCorrelationPropagator is the TraceCorrelationPropagator most
+// callers want: it reads the span that go.opentelemetry.io/otel/trace has
+// attached to ctx, and derives the trace_id, span_id, and trace_flags
+// fields from it.
+type OTelTraceCorrelationPropagator struct{}
+
+// TraceFields implements TraceCorrelationPropagator.
+func (OTelTraceCorrelationPropagator) TraceFields(ctx context.Context) (map[string]interface{}, bool) {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.IsValid() {
+		return nil, false
+	}
+
+	return map[string]interface{}{
+		"trace_id":    spanContext.TraceID().String(),
+		"span_id":     spanContext.SpanID().String(),
+		"trace_flags": spanContext.TraceFlags().String(),
+	}, true
+}
@@ -0,0 +1,75 @@
+package logging
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestOTelTraceCorrelationPropagator_noSpan(t *testing.T) {
+	propagator := OTelTraceCorrelationPropagator{}
+
+	_, ok := propagator.TraceFields(context.Background())
+	if ok {
+		t.Error("expected ok to be false when ctx carries no span")
+	}
+}
+
+func TestOTelTraceCorrelationPropagator_withSpan(t *testing.T) {
+	traceID, err := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	spanID, err := trace.SpanIDFromHex("00f067aa0ba902b7")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+	propagator := OTelTraceCorrelationPropagator{}
+
+	fields, ok := propagator.TraceFields(ctx)
+	if !ok {
+		t.Fatal("expected ok to be true when ctx carries a valid span")
+	}
+
+	if fields["trace_id"] != traceID.String() {
+		t.Errorf("got trace_id %v, expected %v", fields["trace_id"], traceID.String())
+	}
+	if fields["span_id"] != spanID.String() {
+		t.Errorf("got span_id %v, expected %v", fields["span_id"], spanID.String())
+	}
+	if fields["trace_flags"] != trace.FlagsSampled.String() {
+		t.Errorf("got trace_flags %v, expected %v", fields["trace_flags"], trace.FlagsSampled.String())
+	}
+}
+
+func TestTFLoggerOpts_TraceFields(t *testing.T) {
+	var opts TFLoggerOpts
+
+	if _, ok := opts.TraceFields(context.Background()); ok {
+		t.Error("expected ok to be false when no propagator has been configured")
+	}
+
+	opts = WithTraceCorrelationPropagator(OTelTraceCorrelationPropagator{})(opts)
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+	fields, ok := opts.TraceFields(ctx)
+	if !ok {
+		t.Fatal("expected ok to be true once a propagator is configured and ctx carries a span")
+	}
+	if fields["trace_id"] != traceID.String() {
+		t.Errorf("got trace_id %v, expected %v", fields["trace_id"], traceID.String())
+	}
+}
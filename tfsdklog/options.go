@@ -0,0 +1,91 @@
+package tfsdklog
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/internal/logging"
+)
+
+// WithTraceCorrelation returns an option that configures a root logger
+// created by NewRootSDKLogger to attach trace_id, span_id, and
+// trace_flags fields to every log entry made with a context.Context that
+// propagator can derive an OpenTelemetry span from.
+//
+// This lets providers that already instrument their API clients with OTel
+// spans stitch their plugin logs to the resulting traces without having to
+// pass the correlation fields to every Trace/Debug/Info/Warn/Error call by
+// hand.
+//
+// logging.OTelTraceCorrelationPropagator{} is the propagator most callers
+// want: it reads the span attached to ctx by
+// go.opentelemetry.io/otel/trace.ContextWithSpan.
+//
+// This only takes effect on NewRootSDKLogger: passing it to
+// NewRootProviderLogger has no effect, since this module has no
+// provider-side Trace/Debug/etc that would consult it.
+func WithTraceCorrelation(propagator logging.TraceCorrelationPropagator) logging.Option {
+	return logging.WithTraceCorrelation(propagator)
+}
+
+// WithSampling returns an option that configures the fields, in addition
+// to the log message itself, that repeated log entries are grouped by for
+// the purposes of WithRateLimit. It has no effect unless WithRateLimit is
+// also passed to the same NewRootSDKLogger call.
+//
+// This only takes effect on NewRootSDKLogger; passing it to
+// NewRootProviderLogger has no effect, since this module has no
+// provider-side Trace/Debug/etc that would consult it.
+func WithSampling(fieldKeys ...string) logging.Option {
+	return logging.WithSampling(logging.SamplingRule{FieldKeys: fieldKeys})
+}
+
+// WithRateLimit returns an option that caps a root logger to emitting a
+// given log entry, or group of entries sharing the fields named in
+// WithSampling, perKey times within window. This is useful for capping the
+// volume of Trace/Debug calls made from a busy polling loop.
+//
+// Once a log entry has been dropped at least once, the next entry sharing
+// its identity after window elapses gains a "sampled_dropped" field
+// reporting how many entries were dropped in between. No summary is
+// written when the window closes by itself: if no later entry with that
+// identity is logged, the dropped count is never reported.
+//
+// This only takes effect on NewRootSDKLogger; see WithSampling.
+func WithRateLimit(perKey int, window time.Duration) logging.Option {
+	return logging.WithRateLimit(perKey, window)
+}
+
+// SinkFormat is the line format a SinkConfig writes its logs in.
+type SinkFormat = logging.SinkFormat
+
+const (
+	// JSONSinkFormat writes each log line as a JSON object, one per line.
+	// This is the format NewRootSDKLogger/NewRootProviderLogger use when
+	// no sinks are configured explicitly.
+	JSONSinkFormat = logging.JSONSinkFormat
+
+	// LogfmtSinkFormat writes each log line as logfmt (key=value) pairs.
+	LogfmtSinkFormat = logging.LogfmtSinkFormat
+
+	// HumanSinkFormat writes each log line in a human-readable form,
+	// suitable for a developer's terminal. Output is colorized only when it
+	// is a terminal.
+	HumanSinkFormat = logging.HumanSinkFormat
+)
+
+// SinkConfig configures one destination a root logger fans its log entries
+// out to; see WithSinks.
+type SinkConfig = logging.SinkConfig
+
+// WithSinks returns an option that fans a root logger's entries out to
+// each of the given sinks, instead of the single JSON-formatted stream
+// NewRootSDKLogger/NewRootProviderLogger otherwise write. This lets a
+// provider, for example, write JSON to stderr for Terraform to consume
+// while simultaneously writing human-readable logs to a local file for a
+// developer.
+//
+// Each call to this function is additive: the sinks are added to the
+// existing configuration.
+func WithSinks(sinks ...SinkConfig) logging.Option {
+	return logging.WithSinks(sinks...)
+}
@@ -16,6 +16,7 @@ func NewRootSDKLogger(ctx context.Context, options ...logging.Option) context.Co
 	if opts.Name == "" {
 		opts.Name = logging.DefaultSDKRootLoggerName
 	}
+	ctx = setSDKRootTFLoggerOpts(ctx, opts)
 	if sink := logging.GetSink(ctx); sink != nil {
 		logger := sink.Named(opts.Name)
 		sinkLoggerOptions := logging.GetSinkOptions(ctx)
@@ -46,12 +47,78 @@ func NewRootSDKLogger(ctx context.Context, options ...logging.Option) context.Co
 		AdditionalLocationOffset: opts.AdditionalLocationOffset,
 	}
 
-	ctx = logging.SetSDKRootLogger(ctx, hclog.New(loggerOptions))
+	ctx = logging.SetSDKRootLogger(ctx, newSinksLogger(opts, loggerOptions))
 	ctx = logging.SetSDKRootLoggerOptions(ctx, loggerOptions)
 
 	return ctx
 }
 
+// newSinksLogger builds the hclog.Logger for opts: a single logger writing
+// JSON to opts.Output if opts.Sinks wasn't used, or one fanning out to
+// every configured sink otherwise. base supplies the location/time/name
+// settings every sink shares.
+func newSinksLogger(opts logging.LoggerOpts, base *hclog.LoggerOptions) hclog.Logger {
+	if len(opts.Sinks) == 0 {
+		return hclog.New(base)
+	}
+
+	loggers := make([]hclog.Logger, len(opts.Sinks))
+	for i, sink := range opts.Sinks {
+		loggers[i] = newSinkLogger(sink, base)
+	}
+
+	if len(loggers) == 1 {
+		return loggers[0]
+	}
+
+	return hclogutils.MultiLogger(loggers...)
+}
+
+// newSinkLogger builds the hclog.Logger for a single SinkConfig, copying
+// the name/location/time settings from base and overriding the
+// level/format/output with the sink's own.
+func newSinkLogger(sink logging.SinkConfig, base *hclog.LoggerOptions) hclog.Logger {
+	sinkOptions := hclogutils.LoggerOptionsCopy(base)
+	sinkOptions.Level = sink.Level
+	sinkOptions.Output = sink.Output
+
+	switch sink.Format {
+	case logging.LogfmtSinkFormat:
+		// go-hclog has no native logfmt writer; transcode its JSON
+		// encoding into logfmt as it's written instead.
+		sinkOptions.JSONFormat = true
+		sinkOptions.Output = logging.NewLogfmtWriter(sink.Output)
+	case logging.HumanSinkFormat:
+		sinkOptions.JSONFormat = false
+		sinkOptions.Color = hclog.AutoColor
+	default:
+		sinkOptions.JSONFormat = true
+	}
+
+	return hclog.New(sinkOptions)
+}
+
+// setSDKRootTFLoggerOpts copies the per-call-site logging configuration
+// carried on opts (as opposed to the omit/mask configuration managed
+// directly through the SDK root logger's context, e.g. by
+// OmitLogWithFieldKeys) onto the TFLoggerOpts consulted by omitOrMask.
+func setSDKRootTFLoggerOpts(ctx context.Context, opts logging.LoggerOpts) context.Context {
+	if opts.TraceCorrelationPropagator == nil && opts.RateLimitRule.PerKey == 0 {
+		return ctx
+	}
+
+	lOpts := logging.GetSDKRootTFLoggerOpts(ctx)
+
+	if opts.TraceCorrelationPropagator != nil {
+		lOpts = logging.WithTraceCorrelationPropagator(opts.TraceCorrelationPropagator)(lOpts)
+	}
+	if opts.RateLimitRule.PerKey > 0 {
+		lOpts = logging.WithSampler(opts.SamplingRule, opts.RateLimitRule)(lOpts)
+	}
+
+	return logging.SetSDKRootTFLoggerOpts(ctx, lOpts)
+}
+
 // NewRootProviderLogger returns a new context.Context that contains a provider
 // logger configured with the passed options.
 func NewRootProviderLogger(ctx context.Context, options ...logging.Option) context.Context {
@@ -89,7 +156,7 @@ func NewRootProviderLogger(ctx context.Context, options ...logging.Option) conte
 		AdditionalLocationOffset: opts.AdditionalLocationOffset,
 	}
 
-	ctx = logging.SetProviderRootLogger(ctx, hclog.New(loggerOptions))
+	ctx = logging.SetProviderRootLogger(ctx, newSinksLogger(opts, loggerOptions))
 	ctx = logging.SetProviderRootLoggerOptions(ctx, loggerOptions)
 
 	return ctx
@@ -221,6 +288,19 @@ func Error(ctx context.Context, msg string, additionalFields ...map[string]inter
 
 func omitOrMask(ctx context.Context, logger hclog.Logger, msg *string, additionalFields []map[string]interface{}) ([]interface{}, bool) {
 	tfLoggerOpts := logging.GetSDKRootTFLoggerOpts(ctx)
+
+	// Stitch this log entry to the OTel span active on ctx, if any, before
+	// it's turned into args below.
+	if traceFields, ok := tfLoggerOpts.TraceFields(ctx); ok {
+		additionalFields = append(additionalFields, traceFields)
+	}
+
+	if allow, summary := tfLoggerOpts.Sample(*msg, additionalFields); !allow {
+		return nil, true
+	} else if summary != nil {
+		additionalFields = append(additionalFields, summary)
+	}
+
 	additionalArgs := hclogutils.MapsToArgs(additionalFields...)
 	impliedArgs := logger.ImpliedArgs()
 
@@ -325,6 +405,39 @@ func MaskFieldValuesWithFieldKeys(ctx context.Context, keys ...string) context.C
 	return logging.SetSDKRootTFLoggerOpts(ctx, lOpts)
 }
 
+// MaskFieldValuesWithRegexes returns a new context.Context that has a modified logger
+// that masks (replaces) with asterisks (`***`) any field value whose string
+// representation matches one of the given regular expressions, regardless
+// of its field key. Unlike MaskFieldValuesWithFieldKeys, this also reaches
+// values nested inside a field's maps, slices, and structs.
+//
+// Each call to this function is additive:
+// the expressions to mask by are added to the existing configuration.
+func MaskFieldValuesWithRegexes(ctx context.Context, expressions ...*regexp.Regexp) context.Context {
+	lOpts := logging.GetSDKRootTFLoggerOpts(ctx)
+
+	lOpts = logging.WithMaskFieldValuesWithRegexes(expressions...)(lOpts)
+
+	return logging.SetSDKRootTFLoggerOpts(ctx, lOpts)
+}
+
+// MaskFieldValuesWithMatchers returns a new context.Context that has a modified logger
+// that masks (replaces) with asterisks (`***`) any field value, however
+// deeply nested inside a map, slice, or struct, that one of the given
+// Matchers determines should be masked. logging.AWSAccessKeyMatcher,
+// logging.BearerTokenMatcher, and logging.PrivateKeyMatcher are built-in
+// Matchers for common secret shapes.
+//
+// Each call to this function is additive:
+// the matchers to mask by are added to the existing configuration.
+func MaskFieldValuesWithMatchers(ctx context.Context, matchers ...logging.Matcher) context.Context {
+	lOpts := logging.GetSDKRootTFLoggerOpts(ctx)
+
+	lOpts = logging.WithMaskFieldValuesWithMatchers(matchers...)(lOpts)
+
+	return logging.SetSDKRootTFLoggerOpts(ctx, lOpts)
+}
+
 // MaskMessageRegexes returns a new context.Context that has a modified logger
 // that masks (replaces) with asterisks (`***`) all message substrings matching one
 // of the given strings.
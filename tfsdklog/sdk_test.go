@@ -0,0 +1,144 @@
+package tfsdklog_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-plugin-log/internal/logging"
+	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
+)
+
+func TestTrace_writesJSONLine(t *testing.T) {
+	var buf bytes.Buffer
+
+	ctx := tfsdklog.NewRootSDKLogger(context.Background(), tfsdklog.WithSinks(tfsdklog.SinkConfig{
+		Level:  hclog.Trace,
+		Format: tfsdklog.JSONSinkFormat,
+		Output: &buf,
+	}))
+
+	tfsdklog.Trace(ctx, "hello", map[string]interface{}{"count": 3})
+
+	var line map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("expected a JSON log line, got %q: %s", buf.String(), err)
+	}
+
+	if line["@message"] != "hello" {
+		t.Errorf("got @message %v, expected hello", line["@message"])
+	}
+	if line["count"] != float64(3) {
+		t.Errorf("got count %v, expected 3", line["count"])
+	}
+}
+
+func TestTrace_maskingConfiguredForUnrelatedFieldsLeavesOthersIntact(t *testing.T) {
+	// End-to-end regression test for a bug where configuring any
+	// regex/Matcher-based masking at all caused every struct-shaped field
+	// logged afterward - including ones with unexported state, like
+	// time.Time and error - to come out as "{}" in the emitted JSON, even
+	// though nothing in them matched.
+	var buf bytes.Buffer
+
+	ctx := tfsdklog.NewRootSDKLogger(context.Background(), tfsdklog.WithSinks(tfsdklog.SinkConfig{
+		Level:  hclog.Trace,
+		Format: tfsdklog.JSONSinkFormat,
+		Output: &buf,
+	}))
+
+	ctx = tfsdklog.MaskFieldValuesWithMatchers(ctx, logging.NewRegexMatcher(regexp.MustCompile(`^never-matches$`)))
+
+	startedAt := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	tfsdklog.Trace(ctx, "msg", map[string]interface{}{
+		"started_at": startedAt,
+		"err":        errors.New("boom"),
+	})
+
+	var line map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("expected a JSON log line, got %q: %s", buf.String(), err)
+	}
+
+	if line["started_at"] != startedAt.Format(time.RFC3339) {
+		t.Errorf("got started_at %v, expected %s", line["started_at"], startedAt.Format(time.RFC3339))
+	}
+	if line["err"] != "boom" {
+		t.Errorf("got err %v, expected boom", line["err"])
+	}
+}
+
+func TestTrace_withMaskFieldValuesWithMatchers_masksMatchingValue(t *testing.T) {
+	var buf bytes.Buffer
+
+	ctx := tfsdklog.NewRootSDKLogger(context.Background(), tfsdklog.WithSinks(tfsdklog.SinkConfig{
+		Level:  hclog.Trace,
+		Format: tfsdklog.JSONSinkFormat,
+		Output: &buf,
+	}))
+
+	ctx = tfsdklog.MaskFieldValuesWithMatchers(ctx, logging.NewRegexMatcher(regexp.MustCompile(`^secret-value$`)))
+
+	tfsdklog.Trace(ctx, "msg", map[string]interface{}{"password": "secret-value"})
+
+	var line map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("expected a JSON log line, got %q: %s", buf.String(), err)
+	}
+
+	if line["password"] != "***" {
+		t.Errorf("got password %v, expected it to be masked", line["password"])
+	}
+}
+
+func TestTrace_withTraceCorrelation_attachesSpanFields(t *testing.T) {
+	var buf bytes.Buffer
+
+	ctx := tfsdklog.NewRootSDKLogger(context.Background(), tfsdklog.WithSinks(tfsdklog.SinkConfig{
+		Level:  hclog.Trace,
+		Format: tfsdklog.JSONSinkFormat,
+		Output: &buf,
+	}), tfsdklog.WithTraceCorrelation(logging.OTelTraceCorrelationPropagator{}))
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID})
+	ctx = trace.ContextWithSpanContext(ctx, spanContext)
+
+	tfsdklog.Trace(ctx, "msg")
+
+	var line map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &line); err != nil {
+		t.Fatalf("expected a JSON log line, got %q: %s", buf.String(), err)
+	}
+
+	if line["trace_id"] != traceID.String() {
+		t.Errorf("got trace_id %v, expected %s", line["trace_id"], traceID.String())
+	}
+}
+
+func TestTrace_withRateLimit_dropsBeyondPerKey(t *testing.T) {
+	var buf bytes.Buffer
+
+	ctx := tfsdklog.NewRootSDKLogger(context.Background(), tfsdklog.WithSinks(tfsdklog.SinkConfig{
+		Level:  hclog.Trace,
+		Format: tfsdklog.JSONSinkFormat,
+		Output: &buf,
+	}), tfsdklog.WithRateLimit(1, time.Hour))
+
+	tfsdklog.Trace(ctx, "repeated")
+	tfsdklog.Trace(ctx, "repeated")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected exactly one log line to be written, got %d: %q", len(lines), buf.String())
+	}
+}